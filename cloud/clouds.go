@@ -146,6 +146,29 @@ type Region struct {
 	StorageEndpoint string
 }
 
+// SupportsAuthType reports whether the cloud supports the specified
+// authentication type.
+func (cloud Cloud) SupportsAuthType(authType AuthType) bool {
+	for _, t := range cloud.AuthTypes {
+		if t == authType {
+			return true
+		}
+	}
+	return false
+}
+
+// PreferredAuthType returns the authentication type that should be used
+// for the cloud when none has been chosen. This is the first of the
+// cloud's auth-types, in the order they are defined, so the result is
+// deterministic. An empty AuthType is returned if the cloud defines no
+// auth-types.
+func (cloud Cloud) PreferredAuthType() AuthType {
+	if len(cloud.AuthTypes) == 0 {
+		return ""
+	}
+	return cloud.AuthTypes[0]
+}
+
 // cloudSet contains cloud definitions, used for marshalling and
 // unmarshalling.
 type cloudSet struct {
@@ -63,6 +63,33 @@ func (s *cloudSuite) TestParseCloudsAuthTypes(c *gc.C) {
 	c.Assert(rackspace.AuthTypes, jc.SameContents, cloud.AuthTypes{"userpass"})
 }
 
+func (s *cloudSuite) TestSupportsAuthTypeSingle(c *gc.C) {
+	clouds := parsePublicClouds(c)
+	rackspace := clouds["rackspace"]
+	c.Assert(rackspace.SupportsAuthType(cloud.UserPassAuthType), jc.IsTrue)
+	c.Assert(rackspace.SupportsAuthType(cloud.AccessKeyAuthType), jc.IsFalse)
+	c.Assert(rackspace.PreferredAuthType(), gc.Equals, cloud.UserPassAuthType)
+}
+
+func (s *cloudSuite) TestSupportsAuthTypeMultiple(c *gc.C) {
+	clouds, err := cloud.ParseCloudMetadata([]byte(`clouds:
+  testing:
+    type: openstack
+    auth-types: [access-key, userpass]
+`))
+	c.Assert(err, jc.ErrorIsNil)
+	multi := clouds["testing"]
+	c.Assert(multi.SupportsAuthType(cloud.AccessKeyAuthType), jc.IsTrue)
+	c.Assert(multi.SupportsAuthType(cloud.UserPassAuthType), jc.IsTrue)
+	c.Assert(multi.SupportsAuthType(cloud.OAuth1AuthType), jc.IsFalse)
+	c.Assert(multi.PreferredAuthType(), gc.Equals, cloud.AccessKeyAuthType)
+}
+
+func (s *cloudSuite) TestPreferredAuthTypeNone(c *gc.C) {
+	c.Assert(cloud.Cloud{}.PreferredAuthType(), gc.Equals, cloud.AuthType(""))
+	c.Assert(cloud.Cloud{}.SupportsAuthType(cloud.EmptyAuthType), jc.IsFalse)
+}
+
 func (s *cloudSuite) TestParseCloudsConfig(c *gc.C) {
 	clouds, err := cloud.ParseCloudMetadata([]byte(`clouds:
   testing:
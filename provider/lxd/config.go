@@ -6,6 +6,9 @@
 package lxd
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/juju/errors"
 	"github.com/juju/schema"
 	"gopkg.in/juju/environschema.v1"
@@ -13,8 +16,32 @@ import (
 	"github.com/juju/juju/environs/config"
 )
 
+// The LXD-specific config keys.
+const (
+	cfgBaseContainer   = "base-container"
+	cfgContainerPrefix = "container-prefix"
+)
+
+// maxContainerPrefixLength leaves room within LXD's 63 character limit
+// on container names for the Juju namespace and the machine id.
+const maxContainerPrefixLength = 30
+
+// validContainerPrefix matches prefixes that, once joined to a Juju
+// container name with a hyphen, produce a valid LXD container name.
+var validContainerPrefix = regexp.MustCompile(`^[a-zA-Z]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`)
+
 var (
-	configSchema                 = environschema.Fields{}
+	configSchema = environschema.Fields{
+		cfgBaseContainer: {
+			Description: "Existing container or container/snapshot to copy new machines from.",
+			Type:        environschema.Tstring,
+		},
+		cfgContainerPrefix: {
+			Description: "Prefix for the names of the model's containers.",
+			Type:        environschema.Tstring,
+			Immutable:   true,
+		},
+	}
 	configFields, configDefaults = func() (schema.Fields, schema.Defaults) {
 		fields, defaults, err := configSchema.ValidationSchema()
 		if err != nil {
@@ -47,8 +74,18 @@ func newValidConfig(cfg *config.Config) (*environConfig, error) {
 		return nil, errors.Trace(err)
 	}
 
+	// Apply the defaults and coerce/validate the custom config attrs.
+	validated, err := cfg.ValidateUnknownAttrs(configFields, configDefaults)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	validCfg, err := cfg.Apply(validated)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	// Build the config.
-	ecfg := newConfig(cfg)
+	ecfg := newConfig(validCfg)
 
 	// Do final (more complex, provider-specific) validation.
 	if err := ecfg.validate(); err != nil {
@@ -58,7 +95,35 @@ func newValidConfig(cfg *config.Config) (*environConfig, error) {
 	return ecfg, nil
 }
 
+// baseContainer returns the container or snapshot that new containers
+// are copied from, or "" if they are launched from an image.
+func (c *environConfig) baseContainer() string {
+	value, _ := c.attrs[cfgBaseContainer].(string)
+	return value
+}
+
+// containerPrefix returns the prefix added to the names of the model's
+// containers, or "" if there is none.
+func (c *environConfig) containerPrefix() string {
+	value, _ := c.attrs[cfgContainerPrefix].(string)
+	return value
+}
+
 // validate validates LXD-specific configuration.
 func (c *environConfig) validate() error {
+	if prefix := c.containerPrefix(); prefix != "" {
+		if len(prefix) > maxContainerPrefixLength {
+			return errors.NotValidf("%s %q longer than %d characters", cfgContainerPrefix, prefix, maxContainerPrefixLength)
+		}
+		if !validContainerPrefix.MatchString(prefix) {
+			return errors.NotValidf("%s %q", cfgContainerPrefix, prefix)
+		}
+	}
+	if base := c.baseContainer(); base != "" {
+		parts := strings.Split(base, "/")
+		if len(parts) > 2 || parts[0] == "" || parts[len(parts)-1] == "" {
+			return errors.NotValidf("%s %q", cfgBaseContainer, base)
+		}
+	}
 	return nil
 }
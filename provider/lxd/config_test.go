@@ -6,6 +6,8 @@
 package lxd_test
 
 import (
+	"strings"
+
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 	"gopkg.in/juju/environschema.v1"
@@ -269,6 +271,63 @@ func (s *configSuite) TestSetConfig(c *gc.C) {
 	}
 }
 
+func (s *configSuite) TestValidateBaseContainer(c *gc.C) {
+	for i, base := range []string{"juju-base", "juju-base/clean"} {
+		c.Logf("test %d: %q", i, base)
+		cfg, err := s.config.Apply(testing.Attrs{"base-container": base})
+		c.Assert(err, jc.ErrorIsNil)
+		validatedConfig, err := s.provider.Validate(cfg, nil)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(validatedConfig.AllAttrs()["base-container"], gc.Equals, base)
+	}
+}
+
+func (s *configSuite) TestValidateBaseContainerInvalid(c *gc.C) {
+	for i, base := range []string{"/clean", "juju-base/", "juju-base/clean/again"} {
+		c.Logf("test %d: %q", i, base)
+		cfg, err := s.config.Apply(testing.Attrs{"base-container": base})
+		c.Assert(err, jc.ErrorIsNil)
+		_, err = s.provider.Validate(cfg, nil)
+		c.Check(err, gc.ErrorMatches, `invalid base config: base-container ".*" not valid`)
+	}
+}
+
+func (s *configSuite) TestValidateContainerPrefix(c *gc.C) {
+	for i, prefix := range []string{"team1", "a", "shared-host-2"} {
+		c.Logf("test %d: %q", i, prefix)
+		cfg, err := s.config.Apply(testing.Attrs{"container-prefix": prefix})
+		c.Assert(err, jc.ErrorIsNil)
+		validatedConfig, err := s.provider.Validate(cfg, nil)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(validatedConfig.AllAttrs()["container-prefix"], gc.Equals, prefix)
+	}
+}
+
+func (s *configSuite) TestValidateContainerPrefixInvalid(c *gc.C) {
+	for i, prefix := range []string{"1team", "-team", "team-", "team_1", "team.1", "team 1"} {
+		c.Logf("test %d: %q", i, prefix)
+		cfg, err := s.config.Apply(testing.Attrs{"container-prefix": prefix})
+		c.Assert(err, jc.ErrorIsNil)
+		_, err = s.provider.Validate(cfg, nil)
+		c.Check(err, gc.ErrorMatches, `invalid base config: container-prefix ".*" not valid`)
+	}
+}
+
+func (s *configSuite) TestValidateContainerPrefixTooLong(c *gc.C) {
+	prefix := strings.Repeat("a", 31)
+	cfg, err := s.config.Apply(testing.Attrs{"container-prefix": prefix})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.provider.Validate(cfg, nil)
+	c.Check(err, gc.ErrorMatches, `invalid base config: container-prefix "a+" longer than 30 characters not valid`)
+}
+
+func (s *configSuite) TestValidateChangeContainerPrefix(c *gc.C) {
+	cfg, err := s.config.Apply(testing.Attrs{"container-prefix": "team1"})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.provider.Validate(cfg, s.config)
+	c.Check(err, gc.ErrorMatches, `invalid config change: cannot change container-prefix from "" to "team1"`)
+}
+
 func (s *configSuite) TestSchema(c *gc.C) {
 	fields := s.provider.(interface {
 		Schema() environschema.Fields
@@ -57,7 +57,7 @@ func newEnviron(
 		return nil, errors.Annotate(err, "invalid config")
 	}
 
-	namespace, err := instance.NewNamespace(cfg.UUID())
+	namespace, err := newNamespace(ecfg)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -184,9 +184,11 @@ func (env *environ) DestroyController(controllerUUID string) error {
 
 func (env *environ) destroyHostedModelResources(controllerUUID string) error {
 	// Destroy all instances with juju-controller-uuid
-	// matching the specified UUID.
-	const prefix = "juju-"
-	instances, err := env.prefixedInstances(prefix)
+	// matching the specified UUID. Each hosted model may have its
+	// own container prefix, so the instances are found by the
+	// namespace marker in their names and identified by metadata.
+	const prefix = ""
+	instances, err := env.jujuInstances()
 	if err != nil {
 		return errors.Annotate(err, "listing instances")
 	}
@@ -134,15 +134,6 @@ func (env *environ) newRawInstance(
 		return nil, errors.Trace(err)
 	}
 
-	// Note: other providers have the ImageMetadata already read for them
-	// and passed in as args.ImageMetadata. However, lxd provider doesn't
-	// use datatype: image-ids, it uses datatype: image-download, and we
-	// don't have a registered cloud/region.
-	imageSources, err := env.getImageSources()
-	if err != nil {
-		return nil, errors.Trace(err)
-	}
-
 	// TODO: support args.Constraints.Arch, we'll want to map from
 
 	// Keep track of StatusCallback output so we may clean up later.
@@ -166,15 +157,31 @@ func (env *environ) newRawInstance(
 	}
 	defer cleanupCallback()
 
-	imageCallback := func(copyProgress string) {
-		statusCallback(status.Allocating, copyProgress)
-	}
+	// When a base container is configured the new container is copied
+	// from it, so there is no image to fetch. LXD checks that the base
+	// container has the requested series and architecture instead.
 	series := args.InstanceConfig.Series
-	image, err := env.raw.EnsureImageExists(series, arch, imageSources, imageCallback)
-	if err != nil {
-		return nil, errors.Trace(err)
+	baseContainer := env.ecfg.baseContainer()
+	var image string
+	if baseContainer == "" {
+		// Note: other providers have the ImageMetadata already read for them
+		// and passed in as args.ImageMetadata. However, lxd provider doesn't
+		// use datatype: image-ids, it uses datatype: image-download, and we
+		// don't have a registered cloud/region.
+		imageSources, err := env.getImageSources()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		imageCallback := func(copyProgress string) {
+			statusCallback(status.Allocating, copyProgress)
+		}
+		image, err = env.raw.EnsureImageExists(series, arch, imageSources, imageCallback)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		cleanupCallback() // Clean out any long line of completed download status
 	}
-	cleanupCallback() // Clean out any long line of completed download status
 
 	cloudcfg, err := cloudinit.New(series)
 	if err != nil {
@@ -191,8 +198,11 @@ func (env *environ) newRawInstance(
 	// TODO(ericsnow) Support multiple networks?
 	// TODO(ericsnow) Use a different net interface name? Configurable?
 	instSpec := lxdclient.InstanceSpec{
-		Name:  hostname,
-		Image: image,
+		Name:   hostname,
+		Image:  image,
+		Source: baseContainer,
+		Series: series,
+		Arch:   arch,
 		//Type:              spec.InstanceType.Name,
 		//Disks:             getDisks(spec, args.Constraints),
 		//NetworkInterfaces: []string{"ExternalNAT"},
@@ -208,9 +218,13 @@ func (env *environ) newRawInstance(
 		// Network is omitted (left empty).
 	}
 
-	logger.Infof("starting instance %q (image %q)...", instSpec.Name, instSpec.Image)
-
-	statusCallback(status.Allocating, "preparing image")
+	if baseContainer != "" {
+		logger.Infof("starting instance %q (copied from %q)...", instSpec.Name, baseContainer)
+		statusCallback(status.Allocating, "copying base container")
+	} else {
+		logger.Infof("starting instance %q (image %q)...", instSpec.Name, instSpec.Image)
+		statusCallback(status.Allocating, "preparing image")
+	}
 	inst, err := env.raw.AddInstance(instSpec)
 	if err != nil {
 		return nil, errors.Trace(err)
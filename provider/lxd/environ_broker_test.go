@@ -6,12 +6,15 @@
 package lxd_test
 
 import (
+	"github.com/juju/errors"
 	gitjujutesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/utils/arch"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/provider/lxd"
+	"github.com/juju/juju/status"
+	"github.com/juju/juju/tools/lxdclient"
 )
 
 type environBrokerSuite struct {
@@ -40,6 +43,85 @@ func (s *environBrokerSuite) TestStartInstance(c *gc.C) {
 	s.Stub.CheckCall(c, 0, "EnsureImageExists", "trusty", "arm64")
 }
 
+func (s *environBrokerSuite) TestStartInstanceFromBaseContainer(c *gc.C) {
+	s.Client.Inst = s.RawInstance
+	s.UpdateConfig(c, map[string]interface{}{
+		"base-container": "juju-base/clean",
+	})
+
+	var messages []string
+	s.StartInstArgs.StatusCallback = func(_ status.Status, msg string, _ map[string]interface{}) error {
+		messages = append(messages, msg)
+		return nil
+	}
+
+	// Patch the host's arch, so the broker will filter tools.
+	s.PatchValue(&arch.HostArch, func() string { return arch.ARM64 })
+
+	result, err := s.Env.StartInstance(s.StartInstArgs)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Instance, gc.DeepEquals, s.Instance)
+	c.Check(messages, jc.DeepEquals, []string{"copying base container", "container started"})
+
+	// No image is needed when copying from a base container.
+	s.Stub.CheckCallNames(c, "AddInstance")
+	spec := s.Stub.Calls()[0].Args[0].(lxdclient.InstanceSpec)
+	c.Check(spec.Source, gc.Equals, "juju-base/clean")
+	c.Check(spec.Image, gc.Equals, "")
+	// LXD checks the base container against the requested series and
+	// the host's arch.
+	c.Check(spec.Series, gc.Equals, "trusty")
+	c.Check(spec.Arch, gc.Equals, arch.ARM64)
+}
+
+func (s *environBrokerSuite) TestStartInstanceMissingBaseContainer(c *gc.C) {
+	s.UpdateConfig(c, map[string]interface{}{
+		"base-container": "juju-base",
+	})
+	s.Stub.SetErrors(errors.NotFoundf(`base container "juju-base"`))
+
+	// Patch the host's arch, so the broker will filter tools.
+	s.PatchValue(&arch.HostArch, func() string { return arch.ARM64 })
+
+	_, err := s.Env.StartInstance(s.StartInstArgs)
+	c.Assert(err, gc.ErrorMatches, `base container "juju-base" not found`)
+	c.Check(errors.IsNotFound(err), jc.IsTrue)
+}
+
+func (s *environBrokerSuite) TestStartInstanceContainerPrefix(c *gc.C) {
+	s.Client.Inst = s.RawInstance
+	s.UpdateConfig(c, map[string]interface{}{
+		"container-prefix": "team1",
+	})
+
+	// Patch the host's arch, so the broker will filter tools.
+	s.PatchValue(&arch.HostArch, func() string { return arch.ARM64 })
+
+	_, err := s.Env.StartInstance(s.StartInstArgs)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.Stub.CheckCallNames(c, "EnsureImageExists", "AddInstance")
+	spec := s.Stub.Calls()[1].Args[0].(lxdclient.InstanceSpec)
+	c.Check(spec.Name, gc.Equals, "team1-juju-f75cba-0")
+}
+
+func (s *environBrokerSuite) TestStopInstancesContainerPrefix(c *gc.C) {
+	s.UpdateConfig(c, map[string]interface{}{
+		"container-prefix": "team1",
+	})
+
+	err := s.Env.StopInstances("team1-juju-f75cba-0")
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.Stub.CheckCalls(c, []gitjujutesting.StubCall{{
+		FuncName: "RemoveInstances",
+		Args: []interface{}{
+			"team1-juju-f75cba-",
+			[]string{"team1-juju-f75cba-0"},
+		},
+	}})
+}
+
 func (s *environBrokerSuite) TestStartInstanceNoTools(c *gc.C) {
 	s.Client.Inst = s.RawInstance
 
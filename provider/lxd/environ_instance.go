@@ -92,21 +92,39 @@ func (env *environ) prefixedInstances(prefix string) ([]*environInstance, error)
 	return results, err
 }
 
+// jujuInstances returns the instances, across all models, whose names
+// are in a Juju namespace. Containers with other names are never
+// returned, whatever their metadata.
+func (env *environ) jujuInstances() ([]*environInstance, error) {
+	instances, err := env.prefixedInstances("")
+	var results []*environInstance
+	for _, inst := range instances {
+		if isJujuContainerName(inst.raw.Name) {
+			results = append(results, inst)
+		}
+	}
+	return results, err
+}
+
 // ControllerInstances returns the IDs of the instances corresponding
 // to juju controllers.
 func (env *environ) ControllerInstances(controllerUUID string) ([]instance.Id, error) {
-	instances, err := env.raw.Instances("juju-", lxdclient.AliveStatuses...)
+	// The controller model's instances are identified by their
+	// metadata rather than by prefix, since container names may
+	// carry a model-specific prefix.
+	instances, err := env.jujuInstances()
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 
 	var results []instance.Id
 	for _, inst := range instances {
-		if inst.Metadata()[tags.JujuController] != controllerUUID {
+		metadata := inst.raw.Metadata()
+		if metadata[tags.JujuController] != controllerUUID {
 			continue
 		}
-		if inst.Metadata()[tags.JujuIsController] == "true" {
-			results = append(results, instance.Id(inst.Name))
+		if metadata[tags.JujuIsController] == "true" {
+			results = append(results, inst.Id())
 		}
 	}
 	if len(results) == 0 {
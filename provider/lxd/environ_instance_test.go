@@ -6,6 +6,8 @@
 package lxd_test
 
 import (
+	"time"
+
 	"github.com/juju/errors"
 	gitjujutesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -15,6 +17,7 @@ import (
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/instance"
 	"github.com/juju/juju/provider/lxd"
+	"github.com/juju/juju/status"
 	coretesting "github.com/juju/juju/testing"
 	"github.com/juju/juju/tools/lxdclient"
 )
@@ -41,6 +44,35 @@ func (s *environInstSuite) TestInstancesOkay(c *gc.C) {
 	c.Check(insts, jc.DeepEquals, expected)
 }
 
+func (s *environInstSuite) TestInstancesUsage(c *gc.C) {
+	s.Client.Insts = []lxdclient.Instance{*s.RawInstance}
+	s.Client.InstUsage = &lxdclient.InstanceUsage{
+		Status:      lxdclient.StatusRunning,
+		Active:      true,
+		CPUTime:     3 * time.Second,
+		MemoryBytes: 128 * 1024 * 1024,
+		Processes:   7,
+	}
+
+	insts, err := s.Env.Instances([]instance.Id{"spam"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(insts, gc.HasLen, 1)
+
+	// Status does not query LXD; usage is read separately.
+	c.Check(insts[0].Status(), jc.DeepEquals, instance.InstanceStatus{
+		Status:  status.Running,
+		Message: lxdclient.StatusRunning,
+	})
+	reporter, ok := insts[0].(lxd.UsageReporter)
+	c.Assert(ok, jc.IsTrue)
+	usage, err := reporter.Usage()
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(usage, jc.DeepEquals, s.Client.InstUsage)
+	s.Stub.CheckCallNames(c, "Instances", "Usage")
+	s.Stub.CheckCall(c, 1, "Usage", "spam")
+}
+
 func (s *environInstSuite) TestInstancesAPI(c *gc.C) {
 	ids := []instance.Id{"spam", "eggs", "ham"}
 	s.Env.Instances(ids)
@@ -96,16 +128,34 @@ func (s *environInstSuite) TestInstancesNoMatch(c *gc.C) {
 }
 
 func (s *environInstSuite) TestControllerInstancesOkay(c *gc.C) {
-	s.Client.Insts = []lxdclient.Instance{*s.RawInstance}
+	s.Client.Insts = []lxdclient.Instance{*s.NewRawInstance(c, "juju-f75cba-0")}
 
 	ids, err := s.Env.ControllerInstances(coretesting.ControllerTag.Id())
 	c.Assert(err, jc.ErrorIsNil)
 
-	c.Check(ids, jc.DeepEquals, []instance.Id{"spam"})
+	c.Check(ids, jc.DeepEquals, []instance.Id{"juju-f75cba-0"})
 	s.BaseSuite.Client.CheckCallNames(c, "Instances")
 	s.BaseSuite.Client.CheckCall(
 		c, 0, "Instances",
-		"juju-",
+		"",
+		[]string{"Starting", "Started", "Running", "Stopping", "Stopped"},
+	)
+}
+
+func (s *environInstSuite) TestControllerInstancesContainerPrefix(c *gc.C) {
+	s.UpdateConfig(c, map[string]interface{}{"container-prefix": "team1"})
+	s.Client.Insts = []lxdclient.Instance{
+		*s.NewRawInstance(c, "team1-juju-f75cba-0"),
+		*s.NewRawInstance(c, "juju-a1b2c3-0"),
+	}
+
+	ids, err := s.Env.ControllerInstances(coretesting.ControllerTag.Id())
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(ids, jc.DeepEquals, []instance.Id{"team1-juju-f75cba-0", "juju-a1b2c3-0"})
+	s.BaseSuite.Client.CheckCall(
+		c, 0, "Instances",
+		"",
 		[]string{"Starting", "Started", "Running", "Stopping", "Stopped"},
 	)
 }
@@ -117,13 +167,28 @@ func (s *environInstSuite) TestControllerInstancesNotBootstrapped(c *gc.C) {
 }
 
 func (s *environInstSuite) TestControllerInstancesMixed(c *gc.C) {
-	other := lxdclient.NewInstance(lxdclient.InstanceSummary{}, nil)
-	s.Client.Insts = []lxdclient.Instance{*s.RawInstance, *other}
+	other := lxdclient.NewInstance(lxdclient.InstanceSummary{Name: "juju-f75cba-1"}, nil)
+	s.Client.Insts = []lxdclient.Instance{*s.NewRawInstance(c, "juju-f75cba-0"), *other}
+
+	ids, err := s.Env.ControllerInstances(coretesting.ControllerTag.Id())
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(ids, jc.DeepEquals, []instance.Id{"juju-f75cba-0"})
+}
+
+func (s *environInstSuite) TestControllerInstancesIgnoresNonJujuNames(c *gc.C) {
+	// Both containers carry controller metadata, but only one has a
+	// name in a Juju namespace.
+	s.UpdateConfig(c, map[string]interface{}{"container-prefix": "team1"})
+	s.Client.Insts = []lxdclient.Instance{
+		*s.NewRawInstance(c, "team1-manual"),
+		*s.NewRawInstance(c, "team1-juju-f75cba-0"),
+	}
 
 	ids, err := s.Env.ControllerInstances(coretesting.ControllerTag.Id())
 	c.Assert(err, jc.ErrorIsNil)
 
-	c.Check(ids, jc.DeepEquals, []instance.Id{"spam"})
+	c.Check(ids, jc.DeepEquals, []instance.Id{"team1-juju-f75cba-0"})
 }
 
 func (s *environInstSuite) TestAdoptResources(c *gc.C) {
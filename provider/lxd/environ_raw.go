@@ -45,6 +45,7 @@ type lxdInstances interface {
 	AddInstance(lxdclient.InstanceSpec) (*lxdclient.Instance, error)
 	RemoveInstances(string, ...string) error
 	Addresses(string) ([]network.Address, error)
+	Usage(string) (*lxdclient.InstanceUsage, error)
 	AttachDisk(string, string, lxdclient.DiskDevice) error
 	RemoveDevice(string, string) error
 }
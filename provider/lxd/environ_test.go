@@ -149,7 +149,45 @@ func (s *environSuite) TestDestroyHostedModels(c *gc.C) {
 	s.Stub.CheckCalls(c, []gitjujutesting.StubCall{
 		{"Ports", []interface{}{fwname}},
 		{"Destroy", nil},
-		{"Instances", []interface{}{"juju-", lxdclient.AliveStatuses}},
-		{"RemoveInstances", []interface{}{"juju-", []string{machine1.Name}}},
+		{"Instances", []interface{}{"", lxdclient.AliveStatuses}},
+		{"RemoveInstances", []interface{}{"", []string{machine1.Name}}},
+	})
+}
+
+func (s *environSuite) TestDestroyHostedModelsDifferentPrefix(c *gc.C) {
+	s.UpdateConfig(c, map[string]interface{}{
+		"controller-uuid":  s.Config.UUID(),
+		"container-prefix": "team1",
+	})
+	s.Stub.ResetCalls()
+
+	// machine0 is in the controller model.
+	machine0 := s.NewRawInstance(c, "team1-juju-controller-machine-0")
+	machine0.InstanceSummary.Metadata["juju-model-uuid"] = s.Config.UUID()
+	machine0.InstanceSummary.Metadata["juju-controller-uuid"] = s.Config.UUID()
+	// machine1 is in a hosted model with a different prefix.
+	machine1 := s.NewRawInstance(c, "team2-juju-hosted-machine-1")
+	machine1.InstanceSummary.Metadata["juju-model-uuid"] = "not-" + s.Config.UUID()
+	machine1.InstanceSummary.Metadata["juju-controller-uuid"] = s.Config.UUID()
+	// machine2 is in a hosted model with no prefix.
+	machine2 := s.NewRawInstance(c, "juju-hosted-machine-2")
+	machine2.InstanceSummary.Metadata["juju-model-uuid"] = "not-" + s.Config.UUID()
+	machine2.InstanceSummary.Metadata["juju-controller-uuid"] = s.Config.UUID()
+	// machine3 is not managed by Juju, even though its name starts
+	// with the controller's prefix and its metadata matches.
+	machine3 := s.NewRawInstance(c, "team1-manual")
+	machine3.InstanceSummary.Metadata["juju-model-uuid"] = "not-" + s.Config.UUID()
+	machine3.InstanceSummary.Metadata["juju-controller-uuid"] = s.Config.UUID()
+	s.Client.Insts = append(s.Client.Insts, *machine0, *machine1, *machine2, *machine3)
+
+	err := s.Env.DestroyController(s.Config.UUID())
+	c.Assert(err, jc.ErrorIsNil)
+
+	fwname := common.EnvFullName(s.Env.Config().UUID())
+	s.Stub.CheckCalls(c, []gitjujutesting.StubCall{
+		{"Ports", []interface{}{fwname}},
+		{"Destroy", nil},
+		{"Instances", []interface{}{"", lxdclient.AliveStatuses}},
+		{"RemoveInstances", []interface{}{"", []string{machine1.Name, machine2.Name}}},
 	})
 }
@@ -19,7 +19,14 @@ type environInstance struct {
 	env *environ
 }
 
+// UsageReporter is implemented by LXD instances, which can report the
+// resources their container is currently using.
+type UsageReporter interface {
+	Usage() (*lxdclient.InstanceUsage, error)
+}
+
 var _ instance.Instance = (*environInstance)(nil)
+var _ UsageReporter = (*environInstance)(nil)
 
 func newInstance(raw *lxdclient.Instance, env *environ) *environInstance {
 	return &environInstance{
@@ -35,23 +42,35 @@ func (inst *environInstance) Id() instance.Id {
 
 // Status implements instance.Instance.
 func (inst *environInstance) Status() instance.InstanceStatus {
-	jujuStatus := status.Pending
 	instStatus := inst.raw.Status()
+	return instance.InstanceStatus{
+		Status:  jujuStatus(instStatus),
+		Message: instStatus,
+	}
+}
+
+// Usage implements UsageReporter. Unlike Status, it queries LXD for
+// the container's current state each time it is called.
+func (inst *environInstance) Usage() (*lxdclient.InstanceUsage, error) {
+	usage, err := inst.env.raw.Usage(inst.raw.Name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return usage, nil
+}
+
+// jujuStatus maps a LXD container status to the Juju instance status.
+func jujuStatus(instStatus string) status.Status {
 	switch instStatus {
 	case lxdclient.StatusStarting, lxdclient.StatusStarted:
-		jujuStatus = status.Allocating
+		return status.Allocating
 	case lxdclient.StatusRunning:
-		jujuStatus = status.Running
+		return status.Running
 	case lxdclient.StatusFreezing, lxdclient.StatusFrozen, lxdclient.StatusThawed, lxdclient.StatusStopping, lxdclient.StatusStopped:
-		jujuStatus = status.Empty
+		return status.Empty
 	default:
-		jujuStatus = status.Empty
+		return status.Empty
 	}
-	return instance.InstanceStatus{
-		Status:  jujuStatus,
-		Message: instStatus,
-	}
-
 }
 
 // Addresses implements instance.Instance.
@@ -6,12 +6,16 @@
 package lxd_test
 
 import (
+	"time"
+
+	"github.com/juju/errors"
 	gitjujutesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/instance"
 	"github.com/juju/juju/provider/lxd"
+	"github.com/juju/juju/status"
 	"github.com/juju/juju/tools/lxdclient"
 )
 
@@ -39,10 +43,44 @@ func (s *instanceSuite) TestID(c *gc.C) {
 func (s *instanceSuite) TestStatus(c *gc.C) {
 	instanceStatus := s.Instance.Status()
 
-	c.Check(instanceStatus.Message, gc.Equals, lxdclient.StatusRunning)
+	c.Check(instanceStatus, jc.DeepEquals, instance.InstanceStatus{
+		Status:  status.Running,
+		Message: lxdclient.StatusRunning,
+	})
 	s.CheckNoAPI(c)
 }
 
+func (s *instanceSuite) TestUsage(c *gc.C) {
+	expected := &lxdclient.InstanceUsage{
+		Status:        lxdclient.StatusRunning,
+		Active:        true,
+		CPUTime:       12500 * time.Millisecond,
+		MemoryBytes:   64 * 1024 * 1024,
+		Processes:     19,
+		BytesReceived: 16 * 1024,
+		BytesSent:     6 * 1024,
+	}
+	s.Client.InstUsage = expected
+
+	usage, err := s.Instance.Usage()
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(usage, jc.DeepEquals, expected)
+	s.Stub.CheckCalls(c, []gitjujutesting.StubCall{{
+		FuncName: "Usage",
+		Args:     []interface{}{"spam"},
+	}})
+}
+
+func (s *instanceSuite) TestUsageError(c *gc.C) {
+	failure := errors.New("<unknown>")
+	s.Stub.SetErrors(failure)
+
+	_, err := s.Instance.Usage()
+
+	c.Check(errors.Cause(err), gc.Equals, failure)
+}
+
 func (s *instanceSuite) TestAddresses(c *gc.C) {
 	addresses, err := s.Instance.Addresses()
 	c.Assert(err, jc.ErrorIsNil)
@@ -0,0 +1,75 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// +build go1.3
+
+package lxd
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/instance"
+)
+
+// newNamespace returns the namespace used to name the model's
+// containers. If the config has a container prefix, every name in the
+// namespace starts with it.
+func newNamespace(ecfg *environConfig) (instance.Namespace, error) {
+	namespace, err := instance.NewNamespace(ecfg.UUID())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if prefix := ecfg.containerPrefix(); prefix != "" {
+		return &prefixedNamespace{namespace, prefix + "-"}, nil
+	}
+	return namespace, nil
+}
+
+// jujuContainerName matches the names of containers in any model's
+// namespace: the "juju-" marker, optionally preceded by a container
+// prefix and its separator.
+var jujuContainerName = regexp.MustCompile(`^([a-zA-Z]([a-zA-Z0-9-]*[a-zA-Z0-9])?-)?juju-`)
+
+// isJujuContainerName reports whether the named container may have
+// been created by Juju, whatever the container prefix of its model.
+func isJujuContainerName(name string) bool {
+	return jujuContainerName.MatchString(name)
+}
+
+// prefixedNamespace is an instance.Namespace that places a fixed prefix
+// in front of every name generated by the wrapped namespace.
+type prefixedNamespace struct {
+	instance.Namespace
+	prefix string
+}
+
+// Prefix implements instance.Namespace.
+func (n *prefixedNamespace) Prefix() string {
+	return n.prefix + n.Namespace.Prefix()
+}
+
+// Hostname implements instance.Namespace.
+func (n *prefixedNamespace) Hostname(machineID string) (string, error) {
+	hostname, err := n.Namespace.Hostname(machineID)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return n.prefix + hostname, nil
+}
+
+// MachineTag implements instance.Namespace.
+func (n *prefixedNamespace) MachineTag(hostname string) (names.MachineTag, error) {
+	if !strings.HasPrefix(hostname, n.prefix) {
+		return names.MachineTag{}, errors.Errorf("hostname %q not from namespace %q", hostname, n.Prefix())
+	}
+	return n.Namespace.MachineTag(hostname[len(n.prefix):])
+}
+
+// Value implements instance.Namespace.
+func (n *prefixedNamespace) Value(s string) string {
+	return n.prefix + n.Namespace.Value(s)
+}
@@ -79,9 +79,16 @@ func (p *environProvider) PrepareConfig(args environs.PrepareConfigParams) (*con
 
 // Validate implements environs.EnvironProvider.
 func (*environProvider) Validate(cfg, old *config.Config) (valid *config.Config, err error) {
-	if _, err := newValidConfig(cfg); err != nil {
+	ecfg, err := newValidConfig(cfg)
+	if err != nil {
 		return nil, errors.Annotate(err, "invalid base config")
 	}
+	if old != nil {
+		oldPrefix := newConfig(old).containerPrefix()
+		if prefix := ecfg.containerPrefix(); prefix != oldPrefix {
+			return nil, errors.Errorf("invalid config change: cannot change %s from %q to %q", cfgContainerPrefix, oldPrefix, prefix)
+		}
+	}
 	return cfg, nil
 }
 
@@ -251,7 +251,7 @@ func (s *BaseSuiteUnpatched) setConfig(c *gc.C, cfg *config.Config) {
 	uuid := cfg.UUID()
 	s.Env.uuid = uuid
 	s.Env.ecfg = s.EnvConfig
-	namespace, err := instance.NewNamespace(uuid)
+	namespace, err := newNamespace(ecfg)
 	c.Assert(err, jc.ErrorIsNil)
 	s.Env.namespace = namespace
 }
@@ -486,6 +486,7 @@ type StubClient struct {
 
 	Insts              []lxdclient.Instance
 	Inst               *lxdclient.Instance
+	InstUsage          *lxdclient.InstanceUsage
 	Server             *api.Server
 	StorageIsSupported bool
 	Volumes            map[string][]api.StorageVolume
@@ -540,6 +541,15 @@ func (conn *StubClient) Addresses(name string) ([]network.Address, error) {
 	}}, nil
 }
 
+func (conn *StubClient) Usage(name string) (*lxdclient.InstanceUsage, error) {
+	conn.AddCall("Usage", name)
+	if err := conn.NextErr(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return conn.InstUsage, nil
+}
+
 func (conn *StubClient) AddCert(cert lxdclient.Cert) error {
 	conn.AddCall("AddCert", cert)
 	return conn.NextErr()
@@ -9,10 +9,14 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/juju/errors"
+	jujuarch "github.com/juju/utils/arch"
+	"github.com/lxc/lxd"
 	"github.com/lxc/lxd/shared"
 	"github.com/lxc/lxd/shared/api"
 
@@ -46,6 +50,8 @@ type rawInstanceClient interface {
 	ListContainers() ([]api.Container, error)
 	ContainerInfo(name string) (*api.Container, error)
 	Init(name string, imgremote string, image string, profiles *[]string, config map[string]string, devices map[string]map[string]string, ephem bool) (*api.Response, error)
+	LocalCopy(source string, name string, config map[string]string, profiles []string, ephemeral bool) (*api.Response, error)
+	SnapshotInfo(snapName string) (*api.ContainerSnapshot, error)
 	Action(name string, action shared.ContainerAction, timeout int, force bool, stateful bool) (*api.Response, error)
 	Delete(name string) (*api.Response, error)
 
@@ -72,6 +78,10 @@ func deviceProperties(device Device) []string {
 }
 
 func (client *instanceClient) addInstance(spec InstanceSpec) error {
+	if spec.Source != "" {
+		return client.copyInstance(spec)
+	}
+
 	imageRemote := spec.ImageRemote
 	if imageRemote == "" {
 		imageRemote = client.remote
@@ -110,6 +120,139 @@ func (client *instanceClient) addInstance(spec InstanceSpec) error {
 		return errors.Trace(err)
 	}
 
+	return client.pushFiles(spec)
+}
+
+// copyInstance creates the container described by the spec by copying
+// the existing container or snapshot named by spec.Source, rather than
+// unpacking an image.
+func (client *instanceClient) copyInstance(spec InstanceSpec) error {
+	source, err := client.sourceInfo(spec.Source)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := source.check(spec); err != nil {
+		return errors.Trace(err)
+	}
+
+	config := spec.config()
+	resp, err := client.raw.LocalCopy(spec.Source, spec.Name, config, spec.Profiles, spec.Ephemeral)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// Like Init, copying is an async operation.
+	if err := client.raw.WaitForSuccess(resp.Operation); err != nil {
+		return errors.Trace(err)
+	}
+
+	if err := client.finishCopy(spec, source); err != nil {
+		if err := client.removeInstance(spec.Name); err != nil {
+			logger.Errorf("could not remove container %q after copying it failed", spec.Name)
+		}
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// finishCopy adds the spec's devices and files to a container copied
+// from the given source.
+func (client *instanceClient) finishCopy(spec InstanceSpec, source *copySource) error {
+	// LocalCopy does not accept devices, so add them to the copy
+	// before it is started. Devices the copy inherited from the
+	// source under the same names are replaced.
+	for name, device := range spec.Devices {
+		if _, ok := source.devices[name]; ok {
+			resp, err := client.raw.ContainerDeviceDelete(spec.Name, name)
+			if err != nil {
+				return errors.Annotatef(err, "replacing device %q", name)
+			}
+			if err := client.raw.WaitForSuccess(resp.Operation); err != nil {
+				return errors.Annotatef(err, "replacing device %q", name)
+			}
+		}
+
+		props := make(Device, len(device))
+		for key, value := range device {
+			if key != "type" {
+				props[key] = value
+			}
+		}
+		resp, err := client.raw.ContainerDeviceAdd(spec.Name, name, device["type"], deviceProperties(props))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := client.raw.WaitForSuccess(resp.Operation); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	return client.pushFiles(spec)
+}
+
+// copySource holds the details of a container or snapshot that new
+// containers are copied from.
+type copySource struct {
+	name         string
+	architecture string
+	config       map[string]string
+	devices      map[string]map[string]string
+}
+
+// sourceInfo returns the details of the container or snapshot a new
+// container is to be copied from. An error satisfying errors.IsNotFound
+// is returned if it does not exist.
+func (client *instanceClient) sourceInfo(name string) (*copySource, error) {
+	source := &copySource{name: name}
+	var err error
+	if strings.Contains(name, "/") {
+		var info *api.ContainerSnapshot
+		info, err = client.raw.SnapshotInfo(name)
+		if err == nil {
+			source.architecture = info.Architecture
+			source.config = info.Config
+			source.devices = info.Devices
+		}
+	} else {
+		var info *api.Container
+		info, err = client.raw.ContainerInfo(name)
+		if err == nil {
+			source.architecture = info.Architecture
+			source.config = info.Config
+			source.devices = info.Devices
+		}
+	}
+	if err == lxd.LXDErrors[http.StatusNotFound] {
+		return nil, errors.NotFoundf("base container %q", name)
+	}
+	if err != nil {
+		return nil, errors.Annotatef(err, "checking base container %q", name)
+	}
+	return source, nil
+}
+
+// check verifies that the source was created from an image of the
+// series and architecture the spec asks for.
+func (source *copySource) check(spec InstanceSpec) error {
+	if spec.Series != "" {
+		series := source.config["image.release"]
+		if series != spec.Series {
+			return errors.Errorf("base container %q has series %q, not %q", source.name, series, spec.Series)
+		}
+	}
+	if spec.Arch != "" {
+		arch := source.config["image.architecture"]
+		if arch == "" {
+			arch = source.architecture
+		}
+		if jujuarch.NormaliseArch(arch) != jujuarch.NormaliseArch(spec.Arch) {
+			return errors.Errorf("base container %q has architecture %q, not %q", source.name, arch, spec.Arch)
+		}
+	}
+	return nil
+}
+
+func (client *instanceClient) pushFiles(spec InstanceSpec) error {
 	for _, file := range spec.Files {
 		logger.Infof("pushing file %q to container %q", file.Path, spec.Name)
 		err := client.raw.PushFile(spec.Name, file.Path, file.GID, file.UID, file.Mode.String(), bytes.NewReader(file.Content))
@@ -140,7 +283,9 @@ func (client *instanceClient) startInstance(spec InstanceSpec) error {
 }
 
 // AddInstance creates a new instance based on the spec's data and
-// returns it. The instance will be created using the client.
+// returns it. The instance will be created using the client. If the
+// spec has a Source, the instance is copied from that container or
+// snapshot instead of being created from an image.
 func (client *instanceClient) AddInstance(spec InstanceSpec) (*Instance, error) {
 	if err := client.addInstance(spec); err != nil {
 		return nil, errors.Trace(err)
@@ -336,6 +481,37 @@ func (client *instanceClient) Addresses(name string) ([]network.Address, error)
 	return addrs, nil
 }
 
+// Usage returns the current resource usage of the named instance. A
+// container that is not running, such as one that is still starting,
+// frozen or stopping, is reported with its status and no usage.
+func (client *instanceClient) Usage(name string) (*InstanceUsage, error) {
+	state, err := client.raw.ContainerState(name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	usage := &InstanceUsage{Status: state.Status}
+	if state.StatusCode != api.Running {
+		return usage, nil
+	}
+
+	usage.Active = true
+	usage.CPUTime = time.Duration(state.CPU.Usage)
+	usage.MemoryBytes = state.Memory.Usage
+	usage.Processes = state.Processes
+	for name, net := range state.Network {
+		if name == container.DefaultLxcBridge || name == container.DefaultLxdBridge {
+			continue
+		}
+		if net.Type == "loopback" {
+			continue
+		}
+		usage.BytesReceived += net.Counters.BytesReceived
+		usage.BytesSent += net.Counters.BytesSent
+	}
+	return usage, nil
+}
+
 // AttachDisk attaches a disk to an instance.
 func (client *instanceClient) AttachDisk(instanceName, deviceName string, disk DiskDevice) error {
 	props := []string{"path=" + disk.Path, "source=" + disk.Source}
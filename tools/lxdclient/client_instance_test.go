@@ -7,9 +7,14 @@ package lxdclient_test
 
 import (
 	"errors"
+	"net/http"
+	"time"
 
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
+	"github.com/lxc/lxd"
+	"github.com/lxc/lxd/shared"
 	lxdapi "github.com/lxc/lxd/shared/api"
 	gc "gopkg.in/check.v1"
 
@@ -43,6 +48,9 @@ var containerStateSample = lxdapi.ContainerState{
 	Status:     "Running",
 	StatusCode: lxdapi.Running,
 	Disk:       map[string]lxdapi.ContainerStateDisk{},
+	CPU: lxdapi.ContainerStateCPU{
+		Usage: 12500000000,
+	},
 	Memory: lxdapi.ContainerStateMemory{
 		Usage:         66486272,
 		UsagePeak:     92405760,
@@ -181,6 +189,57 @@ func (s *addressesSuite) TestAddresses(c *gc.C) {
 	})
 }
 
+func (s *addressesSuite) TestUsage(c *gc.C) {
+	raw := &addressTester{
+		ContainerStateResult: &containerStateSample,
+	}
+	client := lxdclient.NewInstanceClient(raw)
+	usage, err := client.Usage("test")
+	c.Assert(err, jc.ErrorIsNil)
+	// Only eth0 is counted; loopback and the bridges are skipped.
+	c.Check(usage, jc.DeepEquals, &lxdclient.InstanceUsage{
+		Status:        "Running",
+		Active:        true,
+		CPUTime:       12500 * time.Millisecond,
+		MemoryBytes:   66486272,
+		Processes:     19,
+		BytesReceived: 16352,
+		BytesSent:     6238,
+	})
+}
+
+func (s *addressesSuite) TestUsageTransitional(c *gc.C) {
+	state := containerStateSample
+	state.Status = "Stopping"
+	state.StatusCode = lxdapi.Stopping
+	raw := &addressTester{
+		ContainerStateResult: &state,
+	}
+	client := lxdclient.NewInstanceClient(raw)
+	usage, err := client.Usage("test")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, jc.DeepEquals, &lxdclient.InstanceUsage{
+		Status: "Stopping",
+	})
+}
+
+func (s *addressesSuite) TestUsageFrozen(c *gc.C) {
+	state := containerStateSample
+	state.Status = "Frozen"
+	state.StatusCode = lxdapi.Frozen
+	raw := &addressTester{
+		ContainerStateResult: &state,
+	}
+	client := lxdclient.NewInstanceClient(raw)
+	usage, err := client.Usage("test")
+	c.Assert(err, jc.ErrorIsNil)
+	// A frozen container maps to an empty Juju status, so it is not
+	// reported as active either.
+	c.Check(usage, jc.DeepEquals, &lxdclient.InstanceUsage{
+		Status: "Frozen",
+	})
+}
+
 type devicesSuite struct {
 	lxdclient.BaseSuite
 }
@@ -256,3 +315,163 @@ func (s *devicesSuite) TestRemoveDeviceAsyncError(c *gc.C) {
 	err := client.RemoveDevice("instance", "device")
 	c.Assert(err, gc.ErrorMatches, "async error")
 }
+
+type copyInstanceSuite struct {
+	lxdclient.BaseSuite
+}
+
+var _ = gc.Suite(&copyInstanceSuite{})
+
+func (s *copyInstanceSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.Client.Response = &lxdapi.Response{}
+}
+
+func (s *copyInstanceSuite) TestAddInstanceFromSnapshot(c *gc.C) {
+	s.Client.Snapshot = &lxdapi.ContainerSnapshot{
+		Architecture: "x86_64",
+		Config: map[string]string{
+			"image.release":      "xenial",
+			"image.architecture": "amd64",
+		},
+	}
+	client := lxdclient.NewInstanceClient(s.Client)
+	_, err := client.AddInstance(lxdclient.InstanceSpec{
+		Name:     "juju-machine-0",
+		Source:   "juju-base/clean",
+		Series:   "xenial",
+		Arch:     "amd64",
+		Profiles: []string{"default"},
+		Metadata: map[string]string{"user-data": "#cloud-config"},
+		Devices: lxdclient.Devices{
+			"data": lxdclient.Device{"type": "disk", "path": "/srv"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.Stub.CheckCalls(c, []testing.StubCall{
+		{"SnapshotInfo", []interface{}{"juju-base/clean"}},
+		{"LocalCopy", []interface{}{
+			"juju-base/clean",
+			"juju-machine-0",
+			map[string]string{"user.user-data": "#cloud-config"},
+			[]string{"default"},
+			false,
+		}},
+		{"WaitForSuccess", []interface{}{""}},
+		{"ContainerDeviceAdd", []interface{}{"juju-machine-0", "data", "disk", []string{"path=/srv"}}},
+		{"WaitForSuccess", []interface{}{""}},
+		{"Action", []interface{}{"juju-machine-0", shared.Start, -1, false, false}},
+		{"WaitForSuccess", []interface{}{""}},
+		{"ContainerInfo", []interface{}{"juju-machine-0"}},
+	})
+}
+
+func (s *copyInstanceSuite) TestAddInstanceFromContainer(c *gc.C) {
+	client := lxdclient.NewInstanceClient(s.Client)
+	_, err := client.AddInstance(lxdclient.InstanceSpec{
+		Name:   "juju-machine-0",
+		Source: "juju-base",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.Stub.CheckCallNames(c, "ContainerInfo", "LocalCopy", "WaitForSuccess", "Action", "WaitForSuccess", "ContainerInfo")
+	s.Stub.CheckCall(c, 0, "ContainerInfo", "juju-base")
+}
+
+func (s *copyInstanceSuite) TestAddInstanceMissingBase(c *gc.C) {
+	s.Stub.SetErrors(lxd.LXDErrors[http.StatusNotFound])
+	client := lxdclient.NewInstanceClient(s.Client)
+	_, err := client.AddInstance(lxdclient.InstanceSpec{
+		Name:   "juju-machine-0",
+		Source: "juju-base/missing",
+	})
+	c.Assert(err, gc.ErrorMatches, `base container "juju-base/missing" not found`)
+	c.Check(jujuerrors.IsNotFound(err), jc.IsTrue)
+
+	s.Stub.CheckCallNames(c, "SnapshotInfo")
+}
+
+func (s *copyInstanceSuite) TestAddInstanceSeriesMismatch(c *gc.C) {
+	s.Client.Snapshot = &lxdapi.ContainerSnapshot{
+		Config: map[string]string{"image.release": "trusty"},
+	}
+	client := lxdclient.NewInstanceClient(s.Client)
+	_, err := client.AddInstance(lxdclient.InstanceSpec{
+		Name:   "juju-machine-0",
+		Source: "juju-base/clean",
+		Series: "xenial",
+	})
+	c.Assert(err, gc.ErrorMatches, `base container "juju-base/clean" has series "trusty", not "xenial"`)
+
+	s.Stub.CheckCallNames(c, "SnapshotInfo")
+}
+
+func (s *copyInstanceSuite) TestAddInstanceArchMismatch(c *gc.C) {
+	// Without an image.architecture key, the container's architecture
+	// is used.
+	s.Client.Container = &lxdapi.Container{
+		ContainerPut: lxdapi.ContainerPut{Architecture: "x86_64"},
+	}
+	client := lxdclient.NewInstanceClient(s.Client)
+	_, err := client.AddInstance(lxdclient.InstanceSpec{
+		Name:   "juju-machine-0",
+		Source: "juju-base",
+		Arch:   "arm64",
+	})
+	c.Assert(err, gc.ErrorMatches, `base container "juju-base" has architecture "x86_64", not "arm64"`)
+
+	s.Stub.CheckCallNames(c, "ContainerInfo")
+}
+
+func (s *copyInstanceSuite) TestAddInstanceReplacesInheritedDevice(c *gc.C) {
+	s.Client.Snapshot = &lxdapi.ContainerSnapshot{
+		Devices: map[string]map[string]string{
+			"data": {"type": "disk", "path": "/var/data"},
+		},
+	}
+	client := lxdclient.NewInstanceClient(s.Client)
+	_, err := client.AddInstance(lxdclient.InstanceSpec{
+		Name:   "juju-machine-0",
+		Source: "juju-base/clean",
+		Devices: lxdclient.Devices{
+			"data": lxdclient.Device{"type": "disk", "path": "/srv"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.Stub.CheckCallNames(c,
+		"SnapshotInfo",
+		"LocalCopy", "WaitForSuccess",
+		"ContainerDeviceDelete", "WaitForSuccess",
+		"ContainerDeviceAdd", "WaitForSuccess",
+		"Action", "WaitForSuccess",
+		"ContainerInfo",
+	)
+	s.Stub.CheckCall(c, 3, "ContainerDeviceDelete", "juju-machine-0", "data")
+	s.Stub.CheckCall(c, 5, "ContainerDeviceAdd", "juju-machine-0", "data", "disk", []string{"path=/srv"})
+}
+
+func (s *copyInstanceSuite) TestAddInstanceDeviceFailureRemovesCopy(c *gc.C) {
+	failure := errors.New("device already exists")
+	s.Stub.SetErrors(nil, nil, nil, failure)
+	client := lxdclient.NewInstanceClient(s.Client)
+	_, err := client.AddInstance(lxdclient.InstanceSpec{
+		Name:   "juju-machine-0",
+		Source: "juju-base/clean",
+		Devices: lxdclient.Devices{
+			"data": lxdclient.Device{"type": "disk", "path": "/srv"},
+		},
+	})
+	c.Assert(jujuerrors.Cause(err), gc.Equals, failure)
+
+	// The half-created copy is not left behind.
+	s.Stub.CheckCallNames(c,
+		"SnapshotInfo",
+		"LocalCopy", "WaitForSuccess",
+		"ContainerDeviceAdd",
+		"ContainerInfo", "Action", "WaitForSuccess",
+		"Delete", "WaitForSuccess",
+	)
+	s.Stub.CheckCall(c, 7, "Delete", "juju-machine-0")
+}
@@ -91,6 +91,17 @@ type InstanceSpec struct {
 	// the client's remote is used.
 	ImageRemote string
 
+	// Source is the name of an existing container, or of a snapshot
+	// in "container/snapshot" form, from which the new container is
+	// copied. If set, Image and ImageRemote are ignored.
+	Source string
+
+	// Series and Arch, if set, are the OS series and architecture the
+	// container or snapshot named by Source must have been created
+	// with. They are not used when creating from an image.
+	Series string
+	Arch   string
+
 	// Profiles are the names of the container profiles to apply to the
 	// new container, in order.
 	Profiles []string
@@ -164,6 +175,35 @@ type InstanceHardware struct {
 	RootDiskMB uint64
 }
 
+// InstanceUsage describes the resources a LXC container is currently
+// using, as reported by LXD.
+type InstanceUsage struct {
+	// Status is the status of the container when the usage was read.
+	Status string
+
+	// Active is true if the container is running. The usage values
+	// are only set when it is; a container in any other state, such
+	// as one still starting or frozen, reports just its status.
+	Active bool
+
+	// CPUTime is the CPU time consumed by the container.
+	CPUTime time.Duration
+
+	// MemoryBytes is the memory in use by the container.
+	MemoryBytes int64
+
+	// Processes is the number of processes running in the container.
+	Processes int64
+
+	// BytesReceived is the total received over the container's
+	// network interfaces, excluding loopback and bridges.
+	BytesReceived int64
+
+	// BytesSent is the total sent over the container's network
+	// interfaces, excluding loopback and bridges.
+	BytesSent int64
+}
+
 // InstanceSummary captures all the data needed by Instance.
 type InstanceSummary struct {
 	// Name is the "name" of the instance.
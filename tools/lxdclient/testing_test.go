@@ -49,6 +49,8 @@ type stubClient struct {
 
 	Instance   *api.ContainerState
 	Instances  []api.Container
+	Container  *api.Container
+	Snapshot   *api.ContainerSnapshot
 	ReturnCode int
 	Response   *api.Response
 	Aliases    map[string]string
@@ -116,6 +118,26 @@ func (s *stubClient) Init(name, remote, image string, profiles *[]string, config
 	return s.Response, nil
 }
 
+func (s *stubClient) LocalCopy(source string, name string, config map[string]string, profiles []string, ephemeral bool) (*api.Response, error) {
+	s.stub.AddCall("LocalCopy", source, name, config, profiles, ephemeral)
+	if err := s.stub.NextErr(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return s.Response, nil
+}
+
+func (s *stubClient) SnapshotInfo(snapName string) (*api.ContainerSnapshot, error) {
+	s.stub.AddCall("SnapshotInfo", snapName)
+	if err := s.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	if s.Snapshot != nil {
+		return s.Snapshot, nil
+	}
+	return &api.ContainerSnapshot{}, nil
+}
+
 func (s *stubClient) Delete(name string) (*api.Response, error) {
 	s.stub.AddCall("Delete", name)
 	if err := s.stub.NextErr(); err != nil {
@@ -172,6 +194,9 @@ func (s *stubClient) ContainerInfo(name string) (*api.Container, error) {
 	if err := s.stub.NextErr(); err != nil {
 		return nil, err
 	}
+	if s.Container != nil {
+		return s.Container, nil
+	}
 	return &api.Container{}, nil
 }
 